@@ -0,0 +1,46 @@
+package main
+
+import (
+	"github.com/dheeru0198/tic-tac-toe/ai"
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// Difficulty selects how strong an AIPlayer's move selection is.
+type Difficulty int
+
+const (
+	DifficultyEasy Difficulty = iota
+	DifficultyMedium
+	DifficultyHard
+)
+
+// AIPlayer is a computer-controlled opponent. It embeds board.Player so it
+// can be used anywhere a board.Player is expected (name, mark), and adds a
+// Difficulty that governs which ai.Engine TakeTurn delegates to.
+type AIPlayer struct {
+	board.Player
+	Difficulty Difficulty
+}
+
+// NewAIPlayer creates an AIPlayer with the given name, mark, and difficulty.
+func NewAIPlayer(name string, mark rune, difficulty Difficulty) *AIPlayer {
+	return &AIPlayer{Player: board.Player{Name: name, Mark: mark}, Difficulty: difficulty}
+}
+
+// engine returns the ai.Engine backing p's Difficulty.
+func (p *AIPlayer) engine() ai.Engine {
+	switch p.Difficulty {
+	case DifficultyEasy:
+		return ai.Random{}
+	case DifficultyMedium:
+		return ai.Heuristic{}
+	default:
+		return ai.Minimax{}
+	}
+}
+
+// TakeTurn chooses a move for the AI according to its Difficulty and
+// returns the row and column it wants to play. It does not mutate gb.
+func (p *AIPlayer) TakeTurn(gb *board.GameBoard) (row, col int) {
+	return p.engine().ChooseMove(gb, p.Mark)
+}
@@ -0,0 +1,169 @@
+// Package ai provides pluggable move-selection strategies for a computer
+// opponent, from picking any legal move at random up to a full minimax
+// search with alpha-beta pruning.
+package ai
+
+import (
+	"math/rand"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// Engine chooses a move for mark on the current state of gb. It does not
+// mutate gb.
+type Engine interface {
+	ChooseMove(gb *board.GameBoard, mark rune) (row, col int)
+}
+
+// opponentMark returns the mark used by whoever isn't playing mark.
+func opponentMark(mark rune) rune {
+	if mark == 'X' {
+		return 'O'
+	}
+	return 'X'
+}
+
+// findWinningMove returns the first empty cell in cells that completes an
+// immediate win for mark, trying and undoing each one in turn.
+func findWinningMove(gb *board.GameBoard, cells [][2]int, mark rune) (row, col int, ok bool) {
+	for _, cell := range cells {
+		gb.PlaceMark(cell[0], cell[1], mark)
+		won := gb.CheckStatus() == mark
+		gb.UndoLastMove()
+		if won {
+			return cell[0], cell[1], true
+		}
+	}
+	return 0, 0, false
+}
+
+// Random chooses any legal move uniformly at random.
+type Random struct{}
+
+// ChooseMove implements Engine.
+func (Random) ChooseMove(gb *board.GameBoard, mark rune) (int, int) {
+	cells := gb.EmptyCells()
+	choice := cells[rand.Intn(len(cells))]
+	return choice[0], choice[1]
+}
+
+// Heuristic wins immediately if possible, otherwise blocks the opponent's
+// immediate win, otherwise prefers the center (odd sizes only), then a
+// corner, then the first empty cell in row-major order (a side on 3x3).
+type Heuristic struct{}
+
+// ChooseMove implements Engine.
+func (Heuristic) ChooseMove(gb *board.GameBoard, mark rune) (int, int) {
+	cells := gb.EmptyCells()
+	opponent := opponentMark(mark)
+
+	if row, col, ok := findWinningMove(gb, cells, mark); ok {
+		return row, col
+	}
+	if row, col, ok := findWinningMove(gb, cells, opponent); ok {
+		return row, col
+	}
+
+	size := gb.Size()
+	if size%2 == 1 {
+		if center := size / 2; gb.IsCellEmpty(center, center) {
+			return center, center
+		}
+	}
+	for _, cell := range cells {
+		if isCorner(cell[0], cell[1], size) {
+			return cell[0], cell[1]
+		}
+	}
+	return cells[0][0], cells[0][1]
+}
+
+// isCorner reports whether (row, col) is one of the four corners of a
+// size x size board.
+func isCorner(row, col, size int) bool {
+	return (row == 0 || row == size-1) && (col == 0 || col == size-1)
+}
+
+// Minimax chooses moves via a full minimax search with alpha-beta pruning.
+// MaxDepth caps how many plies ahead it searches; zero means unlimited
+// (search to every terminal state).
+type Minimax struct {
+	MaxDepth int
+}
+
+// ChooseMove implements Engine.
+func (m Minimax) ChooseMove(gb *board.GameBoard, mark rune) (int, int) {
+	opponent := opponentMark(mark)
+	bestScore := -1000
+	bestRow, bestCol := -1, -1
+
+	for _, cell := range gb.EmptyCells() {
+		gb.PlaceMark(cell[0], cell[1], mark)
+		score := m.search(gb, mark, opponent, 1, false, -1000, 1000)
+		gb.UndoLastMove()
+
+		if score > bestScore {
+			bestScore = score
+			bestRow, bestCol = cell[0], cell[1]
+		}
+	}
+	return bestRow, bestCol
+}
+
+// search scores the board from the perspective of mark: 10-depth for a win,
+// depth-10 for a loss, 0 for a draw, so that faster wins and slower losses
+// are preferred. It recurses over every empty cell, using gb.CheckStatus
+// (backed by board's precomputed winning lines) for terminal detection, and
+// prunes with alpha-beta cutoffs once alpha >= beta.
+func (m Minimax) search(gb *board.GameBoard, mark, opponent rune, depth int, maximizing bool, alpha, beta int) int {
+	switch gb.CheckStatus() {
+	case mark:
+		return 10 - depth
+	case opponent:
+		return depth - 10
+	case 'D':
+		return 0
+	}
+	if m.MaxDepth > 0 && depth >= m.MaxDepth {
+		return 0
+	}
+
+	cells := gb.EmptyCells()
+	if maximizing {
+		best := -1000
+		for _, cell := range cells {
+			gb.PlaceMark(cell[0], cell[1], mark)
+			score := m.search(gb, mark, opponent, depth+1, false, alpha, beta)
+			gb.UndoLastMove()
+
+			if score > best {
+				best = score
+			}
+			if best > alpha {
+				alpha = best
+			}
+			if alpha >= beta {
+				break
+			}
+		}
+		return best
+	}
+
+	best := 1000
+	for _, cell := range cells {
+		gb.PlaceMark(cell[0], cell[1], opponent)
+		score := m.search(gb, mark, opponent, depth+1, true, alpha, beta)
+		gb.UndoLastMove()
+
+		if score < best {
+			best = score
+		}
+		if best < beta {
+			beta = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	return best
+}
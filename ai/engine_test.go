@@ -0,0 +1,155 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// TestRandom_PicksLegalMove checks that Random always chooses an empty cell.
+func TestRandom_PicksLegalMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+
+	row, col := (Random{}).ChooseMove(gb, 'O')
+
+	if !gb.IsCellEmpty(row, col) {
+		t.Errorf("Expected Random to choose an empty cell, got occupied (%d,%d)", row, col)
+	}
+}
+
+// TestHeuristic_FindsWinningMove checks that Heuristic takes an immediate
+// win when one is available.
+func TestHeuristic_FindsWinningMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+	gb.PlaceMark(0, 1, 'X')
+	gb.PlaceMark(1, 0, 'O')
+	gb.PlaceMark(1, 1, 'O')
+
+	row, col := (Heuristic{}).ChooseMove(gb, 'X')
+
+	if row != 0 || col != 2 {
+		t.Errorf("Expected Heuristic to complete the winning move at (0,2), got (%d,%d)", row, col)
+	}
+}
+
+// TestHeuristic_BlocksOpponentWin checks that Heuristic blocks the
+// opponent's immediate win when it has no winning move of its own.
+func TestHeuristic_BlocksOpponentWin(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 1, 'O')
+	gb.PlaceMark(1, 1, 'O')
+	gb.PlaceMark(0, 0, 'X')
+
+	row, col := (Heuristic{}).ChooseMove(gb, 'X')
+
+	if row != 2 || col != 1 {
+		t.Errorf("Expected Heuristic to block at (2,1), got (%d,%d)", row, col)
+	}
+}
+
+// TestHeuristic_PrefersCenter checks that, with no win or block available,
+// Heuristic takes the center of an empty board.
+func TestHeuristic_PrefersCenter(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+
+	row, col := (Heuristic{}).ChooseMove(gb, 'X')
+
+	if row != 1 || col != 1 {
+		t.Errorf("Expected Heuristic to prefer the center (1,1), got (%d,%d)", row, col)
+	}
+}
+
+// TestMinimax_FindsWinningMove checks that Minimax completes an immediate
+// win instead of any other legal move.
+func TestMinimax_FindsWinningMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+	gb.PlaceMark(0, 1, 'X')
+	gb.PlaceMark(1, 0, 'O')
+	gb.PlaceMark(1, 1, 'O')
+
+	row, col := (Minimax{}).ChooseMove(gb, 'X')
+
+	if row != 0 || col != 2 {
+		t.Errorf("Expected Minimax to complete the winning move at (0,2), got (%d,%d)", row, col)
+	}
+}
+
+// TestMinimax_BlocksOpponentWin checks that Minimax blocks the opponent's
+// immediate win when it has no winning move of its own.
+func TestMinimax_BlocksOpponentWin(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 1, 'O')
+	gb.PlaceMark(1, 1, 'O')
+	gb.PlaceMark(0, 0, 'X')
+
+	row, col := (Minimax{}).ChooseMove(gb, 'X')
+
+	if row != 2 || col != 1 {
+		t.Errorf("Expected Minimax to block at (2,1), got (%d,%d)", row, col)
+	}
+}
+
+// TestMinimax_VsMinimaxAlwaysDraws plays a full game of Minimax against
+// itself from an empty board and asserts the result is always a draw,
+// since perfect play from both sides in tic-tac-toe never produces a
+// winner.
+func TestMinimax_VsMinimaxAlwaysDraws(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	x, o := Minimax{}, Minimax{}
+
+	status := gb.CheckStatus()
+	turn := 0
+	for status == ' ' {
+		var row, col int
+		if turn%2 == 0 {
+			row, col = x.ChooseMove(gb, 'X')
+			gb.PlaceMark(row, col, 'X')
+		} else {
+			row, col = o.ChooseMove(gb, 'O')
+			gb.PlaceMark(row, col, 'O')
+		}
+		status = gb.CheckStatus()
+		turn++
+	}
+
+	if status != 'D' {
+		t.Errorf("Expected Minimax vs Minimax to always end in a draw, got '%c'", status)
+	}
+}
+
+// TestMinimax_NeverLosesAgainstRandom exhaustively explores every position
+// reachable when Minimax (X, moving first) faces an opponent who may play
+// any legal reply at each of their turns, and asserts Minimax is never the
+// loser from any of them.
+func TestMinimax_NeverLosesAgainstRandom(t *testing.T) {
+	hard := Minimax{}
+
+	var explore func(gb *board.GameBoard, hardsTurn bool)
+	explore = func(gb *board.GameBoard, hardsTurn bool) {
+		if status := gb.CheckStatus(); status != ' ' {
+			if status == 'O' {
+				t.Fatalf("Minimax lost from a reachable position (moves so far: %v)", gb.MoveLog())
+			}
+			return
+		}
+
+		if hardsTurn {
+			row, col := hard.ChooseMove(gb, 'X')
+			gb.PlaceMark(row, col, 'X')
+			explore(gb, false)
+			gb.UndoLastMove()
+			return
+		}
+
+		for _, cell := range gb.EmptyCells() {
+			gb.PlaceMark(cell[0], cell[1], 'O')
+			explore(gb, true)
+			gb.UndoLastMove()
+		}
+	}
+
+	explore(board.NewGameBoard(3, 3), true)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// TestAIPlayer_HardFindsWinningMove checks that a Hard AI about to win takes
+// the winning move instead of any other legal move.
+func TestAIPlayer_HardFindsWinningMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	// X has two in a row on row 0; O has a couple of unrelated moves.
+	gb.PlaceMark(0, 0, 'X')
+	gb.PlaceMark(0, 1, 'X')
+	gb.PlaceMark(1, 0, 'O')
+	gb.PlaceMark(1, 1, 'O')
+
+	ai := NewAIPlayer("Hal", 'X', DifficultyHard)
+	row, col := ai.TakeTurn(gb)
+
+	if row != 0 || col != 2 {
+		t.Errorf("Expected Hard AI to complete the winning move at (0,2), got (%d,%d)", row, col)
+	}
+}
+
+// TestAIPlayer_HardBlocksOpponentWin checks that a Hard AI with no winning
+// move of its own blocks the opponent's immediate win.
+func TestAIPlayer_HardBlocksOpponentWin(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	// O has two in a row on column 1; X has no winning move of its own.
+	gb.PlaceMark(0, 1, 'O')
+	gb.PlaceMark(1, 1, 'O')
+	gb.PlaceMark(0, 0, 'X')
+
+	ai := NewAIPlayer("Hal", 'X', DifficultyHard)
+	row, col := ai.TakeTurn(gb)
+
+	if row != 2 || col != 1 {
+		t.Errorf("Expected Hard AI to block at (2,1), got (%d,%d)", row, col)
+	}
+}
+
+// TestAIPlayer_MediumFindsWinningMove checks the Medium heuristic also
+// takes an immediate win when available.
+func TestAIPlayer_MediumFindsWinningMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'O')
+	gb.PlaceMark(0, 1, 'O')
+
+	ai := NewAIPlayer("Mid", 'O', DifficultyMedium)
+	row, col := ai.TakeTurn(gb)
+
+	if row != 0 || col != 2 {
+		t.Errorf("Expected Medium AI to complete the winning move at (0,2), got (%d,%d)", row, col)
+	}
+}
+
+// TestAIPlayer_MediumBlocksOpponentWin checks the Medium heuristic blocks
+// when it has no winning move itself.
+func TestAIPlayer_MediumBlocksOpponentWin(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 1, 'X')
+	gb.PlaceMark(1, 1, 'X')
+	gb.PlaceMark(0, 0, 'O')
+
+	ai := NewAIPlayer("Mid", 'O', DifficultyMedium)
+	row, col := ai.TakeTurn(gb)
+
+	if row != 2 || col != 1 {
+		t.Errorf("Expected Medium AI to block at (2,1), got (%d,%d)", row, col)
+	}
+}
+
+// TestAIPlayer_EasyPicksLegalMove checks that Easy always plays an empty cell.
+func TestAIPlayer_EasyPicksLegalMove(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+
+	ai := NewAIPlayer("Easy", 'O', DifficultyEasy)
+	row, col := ai.TakeTurn(gb)
+
+	if !gb.IsCellEmpty(row, col) {
+		t.Errorf("Expected Easy AI to choose an empty cell, got occupied (%d,%d)", row, col)
+	}
+}
+
+// TestAIPlayer_HardVsHardAlwaysDraws plays a full game of Hard against Hard
+// from an empty board and asserts the result is always a draw, since
+// perfect play from both sides in tic-tac-toe never produces a winner.
+func TestAIPlayer_HardVsHardAlwaysDraws(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	x := NewAIPlayer("X", 'X', DifficultyHard)
+	o := NewAIPlayer("O", 'O', DifficultyHard)
+
+	status := gb.CheckStatus()
+	turn := 0
+	for status == ' ' {
+		var row, col int
+		if turn%2 == 0 {
+			row, col = x.TakeTurn(gb)
+			gb.PlaceMark(row, col, x.Mark)
+		} else {
+			row, col = o.TakeTurn(gb)
+			gb.PlaceMark(row, col, o.Mark)
+		}
+		status = gb.CheckStatus()
+		turn++
+	}
+
+	if status != 'D' {
+		t.Errorf("Expected Hard vs Hard to always end in a draw, got '%c'", status)
+	}
+}
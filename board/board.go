@@ -0,0 +1,251 @@
+// Package board implements the Tic-Tac-Toe game board: an NxN grid, win
+// detection for a configurable run length, and the move bookkeeping needed
+// to undo a move or inspect the moves played so far.
+package board
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Player holds a player's name and their mark ('X' or 'O').
+type Player struct {
+	Name string
+	Mark rune
+}
+
+// GameBoard represents the Tic-Tac-Toe board and game state. The board is
+// square, sized at construction, and a win is any run of winLen identical
+// marks along a row, column, or diagonal.
+type GameBoard struct {
+	board    [][]rune
+	size     int
+	winLen   int
+	winner   rune       // 'X', 'O', 'D' (Draw), or ' ' (Pending)
+	winLine  [][2]int   // cells of the winning run once winner is 'X' or 'O'
+	winLines [][][2]int // every possible winLen-in-a-row line, precomputed once by NewGameBoard
+	moveLog  []PlacedMove
+}
+
+// PlacedMove is the minimal record of a mark placed via PlaceMark, returned
+// by MoveLog for callers that want to inspect or report on moves played so
+// far (e.g. an AI's exhaustive test fixture); it is unrelated to the richer
+// Move type the record package uses for save/replay history.
+type PlacedMove struct {
+	Row, Col int
+	Mark     rune
+}
+
+// lineDirections are the four directions a winning run can extend in:
+// right, down, and the two diagonals. Checking a run in one direction from
+// every cell covers both directions of travel.
+var lineDirections = [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+// NewGameBoard creates and returns a new, empty GameBoard of the given size
+// with the given win length. winLen is clamped to size if it's larger.
+// winLines is precomputed once here instead of re-derived on every
+// CheckStatus call.
+func NewGameBoard(size, winLen int) *GameBoard {
+	if winLen > size {
+		winLen = size
+	}
+	gb := &GameBoard{size: size, winLen: winLen, winner: ' '} // Game is initially pending
+	gb.board = make([][]rune, size)
+	for i := range gb.board {
+		gb.board[i] = make([]rune, size)
+		for j := range gb.board[i] {
+			gb.board[i][j] = ' ' // Initialize with empty spaces
+		}
+	}
+	gb.winLines = computeWinningLines(size, winLen)
+	return gb
+}
+
+// computeWinningLines returns every line of winLen consecutive cells on a
+// size x size board that could be completed for a win: every run of winLen
+// cells along a row, column, or either diagonal. NewGameBoard computes this
+// once so CheckStatus can check marks against fixed lines instead of
+// re-deriving each line's cells on every call.
+func computeWinningLines(size, winLen int) [][][2]int {
+	var lines [][][2]int
+	for _, dir := range lineDirections {
+		for row := 0; row < size; row++ {
+			for col := 0; col < size; col++ {
+				endRow := row + dir[0]*(winLen-1)
+				endCol := col + dir[1]*(winLen-1)
+				if endRow < 0 || endRow >= size || endCol < 0 || endCol >= size {
+					continue
+				}
+				line := make([][2]int, winLen)
+				for step := 0; step < winLen; step++ {
+					line[step] = [2]int{row + dir[0]*step, col + dir[1]*step}
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+// Size returns the board's side length.
+func (gb *GameBoard) Size() int {
+	return gb.size
+}
+
+// WinLength returns the number of identical marks in a row required to win.
+func (gb *GameBoard) WinLength() int {
+	return gb.winLen
+}
+
+// DisplayBoard prints the current state of the game board to the console.
+// Empty cells are shown with their "row,col" coordinates.
+func (gb *GameBoard) DisplayBoard() {
+	fmt.Println("\n    ") // Initial spacing
+	for i := 0; i < gb.size; i++ {
+		if i > 0 {
+			fmt.Println("    " + strings.Repeat("----", gb.size)) // Separator line
+		}
+		rowStr := "    "
+		for j := 0; j < gb.size; j++ {
+			if gb.board[i][j] == ' ' {
+				rowStr += fmt.Sprintf("%d,%d", i, j)
+			} else {
+				rowStr += fmt.Sprintf(" %c ", gb.board[i][j])
+			}
+			if j < gb.size-1 {
+				rowStr += " | "
+			}
+		}
+		fmt.Println(rowStr)
+	}
+	fmt.Println("    ") // Trailing spacing
+}
+
+// At returns the mark at the given row and column, or ' ' if the cell is
+// empty or out of bounds.
+func (gb *GameBoard) At(row, col int) rune {
+	if row < 0 || row >= gb.size || col < 0 || col >= gb.size {
+		return ' '
+	}
+	return gb.board[row][col]
+}
+
+// IsCellEmpty checks if the cell at the given row and column is empty.
+func (gb *GameBoard) IsCellEmpty(row, col int) bool {
+	if row < 0 || row >= gb.size || col < 0 || col >= gb.size {
+		return false // Out of bounds is not considered "empty" in a playable sense
+	}
+	return gb.board[row][col] == ' '
+}
+
+// EmptyCells returns the coordinates of every empty cell on the board.
+func (gb *GameBoard) EmptyCells() [][2]int {
+	var cells [][2]int
+	for i := 0; i < gb.size; i++ {
+		for j := 0; j < gb.size; j++ {
+			if gb.board[i][j] == ' ' {
+				cells = append(cells, [2]int{i, j})
+			}
+		}
+	}
+	return cells
+}
+
+// PlaceMark attempts to place the given mark at the specified row and column.
+// It returns true if the mark was placed successfully (cell was empty and in bounds),
+// and false otherwise. Once CheckStatus has reported a finished game, every
+// further placement is refused until UndoLastMove reopens it.
+func (gb *GameBoard) PlaceMark(row, col int, mark rune) bool {
+	if gb.winner != ' ' {
+		return false
+	}
+	if row >= 0 && row < gb.size && col >= 0 && col < gb.size && gb.board[row][col] == ' ' {
+		gb.board[row][col] = mark
+		gb.moveLog = append(gb.moveLog, PlacedMove{Row: row, Col: col, Mark: mark})
+		return true
+	}
+	return false
+}
+
+// UndoLastMove reverts the most recent mark placed via PlaceMark, clearing
+// its cell and resetting any cached win/draw verdict since undoing a move
+// can only return the game to pending. It returns false if no moves have
+// been made yet.
+func (gb *GameBoard) UndoLastMove() bool {
+	if len(gb.moveLog) == 0 {
+		return false
+	}
+	last := gb.moveLog[len(gb.moveLog)-1]
+	gb.moveLog = gb.moveLog[:len(gb.moveLog)-1]
+	gb.board[last.Row][last.Col] = ' '
+	gb.winner = ' '
+	gb.winLine = nil
+	return true
+}
+
+// MoveLog returns every move placed so far, in the order PlaceMark was
+// called.
+func (gb *GameBoard) MoveLog() []PlacedMove {
+	return gb.moveLog
+}
+
+// CheckStatus evaluates the board for a win, draw, or if the game is still pending.
+// A win is any run of winLen identical marks along a row, column, or diagonal.
+// It updates gb.winner and returns the status ('X', 'O', 'D' for Draw, ' ' for Pending).
+func (gb *GameBoard) CheckStatus() rune {
+	// Check for a win: test every precomputed line rather than re-deriving
+	// each line's cells from scratch.
+	for _, line := range gb.winLines {
+		mark := gb.board[line[0][0]][line[0][1]]
+		if mark == ' ' {
+			continue
+		}
+		won := true
+		for _, cell := range line[1:] {
+			if gb.board[cell[0]][cell[1]] != mark {
+				won = false
+				break
+			}
+		}
+		if won {
+			gb.winner = mark
+			gb.winLine = line
+			return gb.winner
+		}
+	}
+
+	// Check for a draw (no empty cells left and no winner yet)
+	hasEmptyCell := false
+	for i := 0; i < gb.size; i++ {
+		for j := 0; j < gb.size; j++ {
+			if gb.board[i][j] == ' ' {
+				hasEmptyCell = true
+				break
+			}
+		}
+		if hasEmptyCell {
+			break
+		}
+	}
+
+	if !hasEmptyCell {
+		gb.winner = 'D' // Draw
+		gb.winLine = nil
+		return gb.winner
+	}
+
+	gb.winner = ' ' // Pending
+	gb.winLine = nil
+	return gb.winner
+}
+
+// GetWinner returns the current winner of the game ('X', 'O', 'D', or ' ').
+func (gb *GameBoard) GetWinner() rune {
+	return gb.winner
+}
+
+// WinningLine returns the cells of the winning run found by the most recent
+// CheckStatus call, or nil if there is no winner (pending or draw).
+func (gb *GameBoard) WinningLine() [][2]int {
+	return gb.winLine
+}
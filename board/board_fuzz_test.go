@@ -0,0 +1,128 @@
+package board
+
+import "testing"
+
+// seedMoves packs a slice of {row, col, mark} triples into the byte
+// encoding FuzzGameBoard expects: three bytes per move, with the mark byte
+// even for 'X' and odd for 'O'. It lets the table-driven win/draw scenarios
+// in TestCheckStatus double as the fuzz corpus.
+func seedMoves(moves [][3]interface{}) []byte {
+	data := make([]byte, 0, len(moves)*3)
+	for _, m := range moves {
+		mark := byte(0)
+		if m[2].(rune) == 'O' {
+			mark = 1
+		}
+		data = append(data, byte(m[0].(int)), byte(m[1].(int)), mark)
+	}
+	return data
+}
+
+// hasWinningLine reports whether mark has a run of gb.winLen in a row,
+// column, or diagonal, independent of gb.winner. It mirrors the scan in
+// CheckStatus but for a single mark, so invariants can check both marks
+// without relying on CheckStatus having already picked one.
+func hasWinningLine(gb *GameBoard, mark rune) bool {
+	for row := 0; row < gb.size; row++ {
+		for col := 0; col < gb.size; col++ {
+			if gb.board[row][col] != mark {
+				continue
+			}
+			for _, dir := range lineDirections {
+				endRow := row + dir[0]*(gb.winLen-1)
+				endCol := col + dir[1]*(gb.winLen-1)
+				if endRow < 0 || endRow >= gb.size || endCol < 0 || endCol >= gb.size {
+					continue
+				}
+				won := true
+				for step := 1; step < gb.winLen; step++ {
+					if gb.board[row+dir[0]*step][col+dir[1]*step] != mark {
+						won = false
+						break
+					}
+				}
+				if won {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// FuzzGameBoard feeds byte sequences, interpreted as (row, col, mark)
+// tuples, into a fresh 3x3 GameBoard and checks invariants after every
+// placement attempt: PlaceMark never overwrites an occupied cell,
+// CheckStatus is deterministic, at most one mark can be a winner, a draw
+// implies no empty cells remain, and a finished game's status never
+// changes no matter what further moves are attempted.
+func FuzzGameBoard(f *testing.F) {
+	f.Add(seedMoves([][3]interface{}{{0, 0, 'X'}, {0, 1, 'X'}, {0, 2, 'X'}}))
+	f.Add(seedMoves([][3]interface{}{{1, 0, 'X'}, {1, 1, 'X'}, {1, 2, 'X'}}))
+	f.Add(seedMoves([][3]interface{}{{0, 0, 'X'}, {1, 0, 'X'}, {2, 0, 'X'}}))
+	f.Add(seedMoves([][3]interface{}{{0, 0, 'X'}, {1, 1, 'X'}, {2, 2, 'X'}}))
+	f.Add(seedMoves([][3]interface{}{{0, 2, 'X'}, {1, 1, 'X'}, {2, 0, 'X'}}))
+	f.Add(seedMoves([][3]interface{}{{0, 0, 'O'}, {0, 1, 'O'}, {0, 2, 'O'}}))
+	f.Add(seedMoves([][3]interface{}{{0, 1, 'O'}, {1, 1, 'O'}, {2, 1, 'O'}}))
+	f.Add(seedMoves([][3]interface{}{
+		{0, 0, 'X'}, {0, 1, 'O'}, {0, 2, 'X'},
+		{1, 0, 'X'}, {1, 1, 'X'}, {1, 2, 'O'},
+		{2, 0, 'O'}, {2, 1, 'X'}, {2, 2, 'O'},
+	}))
+	f.Add([]byte{})
+	f.Add([]byte{255, 255, 255, 0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		const size = 3
+		gb := NewGameBoard(size, size)
+		finalStatus := rune(' ')
+
+		for i := 0; i+2 < len(data); i += 3 {
+			row := int(int8(data[i]))
+			col := int(int8(data[i+1]))
+			mark := rune('X')
+			if data[i+2]%2 == 1 {
+				mark = 'O'
+			}
+
+			var occupant rune
+			inBounds := row >= 0 && row < size && col >= 0 && col < size
+			if inBounds {
+				occupant = gb.board[row][col]
+			}
+
+			placed := gb.PlaceMark(row, col, mark)
+
+			if inBounds && occupant != ' ' && placed {
+				t.Fatalf("PlaceMark overwrote occupied cell (%d,%d): was %q", row, col, occupant)
+			}
+			if inBounds && occupant != ' ' && gb.board[row][col] != occupant {
+				t.Fatalf("occupied cell (%d,%d) changed from %q to %q after a rejected PlaceMark", row, col, occupant, gb.board[row][col])
+			}
+
+			status := gb.CheckStatus()
+			if again := gb.CheckStatus(); again != status {
+				t.Fatalf("CheckStatus is not deterministic: got %q then %q", status, again)
+			}
+
+			if hasWinningLine(gb, 'X') && hasWinningLine(gb, 'O') {
+				t.Fatalf("both X and O have a winning line simultaneously")
+			}
+
+			if status == 'D' {
+				for r := 0; r < size; r++ {
+					for c := 0; c < size; c++ {
+						if gb.board[r][c] == ' ' {
+							t.Fatalf("status is 'D' but cell (%d,%d) is still empty", r, c)
+						}
+					}
+				}
+			}
+
+			if finalStatus != ' ' && status != finalStatus {
+				t.Fatalf("status changed from %q to %q after the game had already ended", finalStatus, status)
+			}
+			finalStatus = status
+		}
+	})
+}
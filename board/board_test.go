@@ -0,0 +1,228 @@
+package board
+
+import "testing"
+
+func TestNewGameBoard(t *testing.T) {
+	gb := NewGameBoard(3, 3)
+	if gb == nil {
+		t.Fatal("NewGameBoard returned nil")
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if gb.At(i, j) != ' ' {
+				t.Errorf("Expected board cell (%d,%d) to be ' ', got '%c'", i, j, gb.At(i, j))
+			}
+		}
+	}
+
+	if gb.winner != ' ' {
+		t.Errorf("Expected initial winner state to be ' ', got '%c'", gb.winner)
+	}
+}
+
+func TestPlaceMark(t *testing.T) {
+	gb := NewGameBoard(3, 3)
+
+	t.Run("ValidPlacement", func(t *testing.T) {
+		success := gb.PlaceMark(0, 0, 'X')
+		if !success {
+			t.Error("PlaceMark(0,0,'X') failed unexpectedly")
+		}
+		if gb.At(0, 0) != 'X' {
+			t.Errorf("Expected 'X' at (0,0), got '%c'", gb.At(0, 0))
+		}
+	})
+
+	t.Run("OccupiedCell", func(t *testing.T) {
+		gb.PlaceMark(1, 1, 'O')            // Place initial mark
+		success := gb.PlaceMark(1, 1, 'X') // Attempt to place on occupied cell
+		if success {
+			t.Error("PlaceMark on occupied cell (1,1) unexpectedly succeeded")
+		}
+		if gb.At(1, 1) != 'O' { // Should still be 'O'
+			t.Errorf("Expected 'O' at (1,1) after failed placement, got '%c'", gb.At(1, 1))
+		}
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		if gb.PlaceMark(-1, 0, 'X') {
+			t.Error("PlaceMark(-1,0,'X') unexpectedly succeeded (out of bounds)")
+		}
+		if gb.PlaceMark(0, 3, 'O') {
+			t.Error("PlaceMark(0,3,'O') unexpectedly succeeded (out of bounds)")
+		}
+		if gb.PlaceMark(3, 3, 'X') {
+			t.Error("PlaceMark(3,3,'X') unexpectedly succeeded (out of bounds)")
+		}
+	})
+}
+
+func TestIsCellEmpty(t *testing.T) {
+	gb := NewGameBoard(3, 3)
+	if !gb.IsCellEmpty(0, 0) {
+		t.Error("Expected cell (0,0) to be empty initially")
+	}
+	gb.PlaceMark(0, 0, 'X')
+	if gb.IsCellEmpty(0, 0) {
+		t.Error("Expected cell (0,0) to be non-empty after placing mark")
+	}
+}
+
+func TestCheckStatus(t *testing.T) {
+	testCases := []struct {
+		name         string
+		moves        [][3]interface{} // {row, col, mark}
+		expectedMark rune             // 'X', 'O', 'D' (Draw), ' ' (Pending)
+	}{
+		{"Pending_EmptyBoard", []([3]interface{}){}, ' '},
+		{"Pending_SomeMoves", []([3]interface{}){{0, 0, 'X'}, {1, 1, 'O'}}, ' '},
+		// Win Conditions for X
+		{"Win_X_Row0", []([3]interface{}){{0, 0, 'X'}, {0, 1, 'X'}, {0, 2, 'X'}}, 'X'},
+		{"Win_X_Row1", []([3]interface{}){{1, 0, 'X'}, {1, 1, 'X'}, {1, 2, 'X'}}, 'X'},
+		{"Win_X_Row2", []([3]interface{}){{2, 0, 'X'}, {2, 1, 'X'}, {2, 2, 'X'}}, 'X'},
+		{"Win_X_Col0", []([3]interface{}){{0, 0, 'X'}, {1, 0, 'X'}, {2, 0, 'X'}}, 'X'},
+		{"Win_X_Col1", []([3]interface{}){{0, 1, 'X'}, {1, 1, 'X'}, {2, 1, 'X'}}, 'X'},
+		{"Win_X_Col2", []([3]interface{}){{0, 2, 'X'}, {1, 2, 'X'}, {2, 2, 'X'}}, 'X'},
+		{"Win_X_DiagMain", []([3]interface{}){{0, 0, 'X'}, {1, 1, 'X'}, {2, 2, 'X'}}, 'X'},
+		{"Win_X_DiagAnti", []([3]interface{}){{0, 2, 'X'}, {1, 1, 'X'}, {2, 0, 'X'}}, 'X'},
+		// Win Conditions for O (similar structure)
+		{"Win_O_Row0", []([3]interface{}){{0, 0, 'O'}, {0, 1, 'O'}, {0, 2, 'O'}}, 'O'},
+		{"Win_O_Col1", []([3]interface{}){{0, 1, 'O'}, {1, 1, 'O'}, {2, 1, 'O'}}, 'O'},
+		{"Win_O_DiagMain", []([3]interface{}){{0, 0, 'O'}, {1, 1, 'O'}, {2, 2, 'O'}}, 'O'},
+		// Draw Condition
+		{
+			"Draw",
+			[]([3]interface{}){
+				{0, 0, 'X'}, {0, 1, 'O'}, {0, 2, 'X'},
+				{1, 0, 'X'}, {1, 1, 'X'}, {1, 2, 'O'},
+				{2, 0, 'O'}, {2, 1, 'X'}, {2, 2, 'O'},
+			},
+			'D',
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gb := NewGameBoard(3, 3)
+			for _, move := range tc.moves {
+				row := move[0].(int)
+				col := move[1].(int)
+				mark := move[2].(rune)
+				gb.PlaceMark(row, col, mark)
+			}
+			status := gb.CheckStatus()
+			if status != tc.expectedMark {
+				t.Errorf("Expected status '%c', got '%c'", tc.expectedMark, status)
+			}
+			if gb.GetWinner() != tc.expectedMark {
+				t.Errorf("Expected gb.GetWinner() to be '%c', got '%c'", tc.expectedMark, gb.GetWinner())
+			}
+		})
+	}
+}
+
+func TestNewGameBoard_ClampsWinLenToSize(t *testing.T) {
+	gb := NewGameBoard(3, 5)
+	if gb.WinLength() != 3 {
+		t.Errorf("Expected winLen to be clamped to board size 3, got %d", gb.WinLength())
+	}
+	if gb.Size() != 3 {
+		t.Errorf("Expected size to remain 3, got %d", gb.Size())
+	}
+}
+
+func TestCheckStatus_NxN(t *testing.T) {
+	testCases := []struct {
+		name         string
+		size         int
+		winLen       int
+		moves        [][3]interface{} // {row, col, mark}
+		expectedMark rune
+	}{
+		{
+			"4x4_WinLen3_Row",
+			4, 3,
+			[]([3]interface{}){{1, 0, 'X'}, {1, 1, 'X'}, {1, 2, 'X'}},
+			'X',
+		},
+		{
+			"4x4_WinLen3_Diagonal",
+			4, 3,
+			[]([3]interface{}){{0, 1, 'O'}, {1, 2, 'O'}, {2, 3, 'O'}},
+			'O',
+		},
+		{
+			"4x4_WinLen3_Pending",
+			4, 3,
+			[]([3]interface{}){{0, 0, 'X'}, {0, 1, 'X'}, {3, 3, 'O'}},
+			' ',
+		},
+		{
+			"5x5_WinLen4_Column",
+			5, 4,
+			[]([3]interface{}){{0, 2, 'X'}, {1, 2, 'X'}, {2, 2, 'X'}, {3, 2, 'X'}},
+			'X',
+		},
+		{
+			"5x5_WinLen4_ShortRunDoesNotWin",
+			5, 4,
+			[]([3]interface{}){{0, 0, 'O'}, {0, 1, 'O'}, {0, 2, 'O'}},
+			' ',
+		},
+		{
+			"5x5_WinLen4_AntiDiagonal",
+			5, 4,
+			[]([3]interface{}){{0, 4, 'X'}, {1, 3, 'X'}, {2, 2, 'X'}, {3, 1, 'X'}},
+			'X',
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gb := NewGameBoard(tc.size, tc.winLen)
+			for _, move := range tc.moves {
+				row := move[0].(int)
+				col := move[1].(int)
+				mark := move[2].(rune)
+				gb.PlaceMark(row, col, mark)
+			}
+			status := gb.CheckStatus()
+			if status != tc.expectedMark {
+				t.Errorf("Expected status '%c', got '%c'", tc.expectedMark, status)
+			}
+		})
+	}
+}
+
+// TestComputeWinningLines checks the number of precomputed lines for a few
+// (size, winLen) combinations: 3 rows + 3 cols + 2 diagonals for a classic
+// 3x3x3 board, and the shrinking diagonal count as winLen grows relative to
+// size.
+func TestComputeWinningLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		size     int
+		winLen   int
+		expected int
+	}{
+		{"3x3_WinLen3", 3, 3, 8},
+		{"4x4_WinLen3", 4, 3, 24},
+		{"4x4_WinLen4", 4, 4, 10},
+		{"5x5_WinLen4", 5, 4, 28},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lines := computeWinningLines(tc.size, tc.winLen)
+			if len(lines) != tc.expected {
+				t.Errorf("Expected %d winning lines, got %d", tc.expected, len(lines))
+			}
+			for _, line := range lines {
+				if len(line) != tc.winLen {
+					t.Errorf("Expected every line to have %d cells, got %d", tc.winLen, len(line))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// protocolVersion is sent in every hello frame so a future change to the
+// wire format can be detected and rejected cleanly instead of silently
+// misparsed.
+const protocolVersion = 1
+
+// frame is the newline-delimited JSON envelope exchanged over a Session.
+// Type selects which of the other fields are meaningful; zero-value fields
+// are omitted from the wire via `omitempty`.
+type frame struct {
+	Type    string `json:"type"`
+	Version int    `json:"version,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Mark    string `json:"mark,omitempty"`
+	Size    int    `json:"size,omitempty"`
+	K       int    `json:"k,omitempty"`
+	Row     int    `json:"row,omitempty"`
+	Col     int    `json:"col,omitempty"`
+	Ord     int    `json:"ord,omitempty"`
+	Cells   string `json:"cells,omitempty"`
+	Winner  string `json:"winner,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Session is a newline-delimited-JSON connection to a network opponent.
+// ServeGame and JoinGame each wrap their net.Conn in one and exchange
+// frames over it until the game ends.
+type Session struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+// newSession wraps conn in a Session ready to send and receive frames.
+func newSession(conn net.Conn) *Session {
+	return &Session{conn: conn, reader: bufio.NewReader(conn), writer: bufio.NewWriter(conn)}
+}
+
+// SendMove sends a "move" frame for mv with the given move ordinal.
+func (s *Session) SendMove(mv Move, ord int) error {
+	return s.sendFrame(frame{Type: "move", Row: mv.Row, Col: mv.Col, Ord: ord})
+}
+
+// RecvMove blocks for the next frame and returns it as a Move. It returns an
+// error if the frame that arrives isn't a "move" frame.
+func (s *Session) RecvMove() (Move, error) {
+	f, err := s.recvFrame()
+	if err != nil {
+		return Move{}, err
+	}
+	if f.Type != "move" {
+		return Move{}, fmt.Errorf("expected a move frame, got %q", f.Type)
+	}
+	return Move{Row: f.Row, Col: f.Col}, nil
+}
+
+// Close closes the underlying connection.
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// sendFrame encodes f as a single line of JSON and flushes it.
+func (s *Session) sendFrame(f frame) error {
+	encoded, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("encoding frame: %w", err)
+	}
+	if _, err := s.writer.Write(append(encoded, '\n')); err != nil {
+		return err
+	}
+	return s.writer.Flush()
+}
+
+// recvFrame reads a single line of JSON and decodes it into a frame.
+func (s *Session) recvFrame() (frame, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return frame{}, err
+	}
+	var f frame
+	if err := json.Unmarshal([]byte(strings.TrimSpace(line)), &f); err != nil {
+		return frame{}, fmt.Errorf("decoding frame %q: %w", strings.TrimSpace(line), err)
+	}
+	return f, nil
+}
+
+// ServeGame accepts a single opponent connection on listener and plays a
+// full game against them: the local player is X and moves first via stdin,
+// the remote player is O and sends moves as "move" frames. The board state
+// is broadcast to the remote player as a "state" frame after every move.
+func ServeGame(listener net.Listener) error {
+	conn, err := listener.Accept()
+	if err != nil {
+		return fmt.Errorf("accepting opponent connection: %w", err)
+	}
+	defer conn.Close()
+	return serveSession(newSession(conn), bufio.NewReader(os.Stdin))
+}
+
+// JoinGame dials a host started with ServeGame and plays as O: it waits for
+// board state broadcasts and, once it's O's turn, prompts for a move on
+// stdin and sends it to the host.
+func JoinGame(addr string) error {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	return joinSession(newSession(conn), bufio.NewReader(os.Stdin), "Guest")
+}
+
+// serveSession runs the host side of the protocol over sess, taking its own
+// moves from localInput. It is split out from ServeGame so tests can drive
+// it over a Session backed by net.Pipe without a real listener.
+func serveSession(sess *Session, localInput *bufio.Reader) error {
+	hello, err := sess.recvFrame()
+	if err != nil {
+		return fmt.Errorf("reading hello frame: %w", err)
+	}
+	if hello.Type != "hello" {
+		return sess.sendFrame(frame{Type: "error", Message: "expected a hello frame"})
+	}
+	if hello.Version != protocolVersion {
+		return sess.sendFrame(frame{Type: "error", Message: fmt.Sprintf("unsupported protocol version %d", hello.Version)})
+	}
+	fmt.Printf("%s has connected.\n", hello.Name)
+
+	host := board.Player{Name: "Host", Mark: 'X'}
+	gb := board.NewGameBoard(3, 3)
+	var history []Move
+
+	if err := sess.sendFrame(frame{Type: "hello", Version: protocolVersion, Name: host.Name, Mark: "O", Size: gb.Size(), K: gb.WinLength()}); err != nil {
+		return err
+	}
+
+	status := gb.CheckStatus()
+	for status == ' ' {
+		gb.DisplayBoard()
+		fmt.Println("\nChoose a position from available positions on the board (e.g., 0,1).")
+		handlePlayerTurn(localInput, host, 0, gb, &history)
+
+		if err := sess.sendFrame(stateFrame(gb)); err != nil {
+			return err
+		}
+		status = gb.CheckStatus()
+		if status != ' ' {
+			break
+		}
+
+		mv, err := sess.RecvMove()
+		if err != nil {
+			return fmt.Errorf("reading remote move: %w", err)
+		}
+		if !gb.IsCellEmpty(mv.Row, mv.Col) || !gb.PlaceMark(mv.Row, mv.Col, 'O') {
+			if err := sess.sendFrame(frame{Type: "error", Message: fmt.Sprintf("illegal move %d,%d", mv.Row, mv.Col)}); err != nil {
+				return err
+			}
+			continue
+		}
+		history = append(history, Move{Row: mv.Row, Col: mv.Col, Mark: 'O', PlayerIndex: 1, Timestamp: time.Now()})
+
+		if err := sess.sendFrame(stateFrame(gb)); err != nil {
+			return err
+		}
+		status = gb.CheckStatus()
+	}
+
+	gb.DisplayBoard()
+	return sess.sendFrame(frame{Type: "result", Winner: string(status)})
+}
+
+// joinSession runs the guest side of the protocol over sess, taking its own
+// moves from localInput once the board state shows it's O's turn.
+func joinSession(sess *Session, localInput *bufio.Reader, name string) error {
+	if err := sess.sendFrame(frame{Type: "hello", Version: protocolVersion, Name: name, Mark: "O"}); err != nil {
+		return err
+	}
+
+	reply, err := sess.recvFrame()
+	if err != nil {
+		return fmt.Errorf("reading hello reply: %w", err)
+	}
+	if reply.Type != "hello" {
+		return fmt.Errorf("expected a hello frame, got %q", reply.Type)
+	}
+
+	for {
+		f, err := sess.recvFrame()
+		if err != nil {
+			return fmt.Errorf("connection closed before a result arrived: %w", err)
+		}
+
+		switch f.Type {
+		case "result":
+			fmt.Printf("Game over. Result: %s\n", f.Winner)
+			return nil
+		case "error":
+			fmt.Println("Host reported an error:", f.Message)
+		case "state":
+			gb, err := decodeCells(f.Size, f.K, f.Cells)
+			if err != nil {
+				return err
+			}
+			gb.DisplayBoard()
+			// Only prompt when the game is still pending: filledCells parity
+			// alone also matches the state that comes from the host's own
+			// game-ending move, which would otherwise make the guest wait
+			// for a move that's no longer theirs to make.
+			if gb.CheckStatus() == ' ' && filledCells(gb)%2 == 1 {
+				fmt.Println("\nYour turn (O). Choose a position (e.g., 0,1).")
+				row, col := promptNetworkMove(localInput, gb)
+				if err := sess.SendMove(Move{Row: row, Col: col}, filledCells(gb)+1); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unexpected frame type %q", f.Type)
+		}
+	}
+}
+
+// promptNetworkMove asks localInput for a row,col move that is in bounds
+// and lands on an empty cell of gb, re-prompting on invalid input just like
+// handlePlayerTurn does for the local stdin game.
+func promptNetworkMove(reader *bufio.Reader, gb *board.GameBoard) (row, col int) {
+	for {
+		moveInput := getInput("Guest: ", reader)
+		parts := strings.Split(moveInput, ",")
+		if len(parts) != 2 {
+			fmt.Println("Invalid format. Please use row,col (e.g., 0,1).")
+			continue
+		}
+
+		r, errRow := strconv.Atoi(strings.TrimSpace(parts[0]))
+		c, errCol := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if errRow != nil || errCol != nil {
+			fmt.Println("Invalid format. Please enter numbers for row and column (e.g., 0,1).")
+			continue
+		}
+		if !gb.IsCellEmpty(r, c) {
+			fmt.Println("Cell already occupied or out of bounds. Choose an empty cell.")
+			continue
+		}
+		return r, c
+	}
+}
+
+// stateFrame builds a "state" frame carrying the current board encoding.
+func stateFrame(gb *board.GameBoard) frame {
+	return frame{Type: "state", Size: gb.Size(), K: gb.WinLength(), Cells: encodeCells(gb)}
+}
+
+// encodeCells flattens a board into a string of its cells in row-major
+// order, with empty cells written as '.'.
+func encodeCells(gb *board.GameBoard) string {
+	var sb strings.Builder
+	for i := 0; i < gb.Size(); i++ {
+		for j := 0; j < gb.Size(); j++ {
+			if mark := gb.At(i, j); mark == ' ' {
+				sb.WriteByte('.')
+			} else {
+				sb.WriteRune(mark)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// decodeCells parses a flattened cell string of the given size and win
+// length back into a GameBoard, so the guest can run its own CheckStatus
+// against the same winning lines the host uses.
+func decodeCells(size, winLen int, cells string) (*board.GameBoard, error) {
+	if len(cells) != size*size {
+		return nil, fmt.Errorf("expected %d cells, got %d", size*size, len(cells))
+	}
+
+	gb := board.NewGameBoard(size, winLen)
+	idx := 0
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			if ch := rune(cells[idx]); ch != '.' {
+				gb.PlaceMark(i, j, ch)
+			}
+			idx++
+		}
+	}
+	return gb, nil
+}
+
+// filledCells counts the non-empty cells on gb, used to infer whose turn it
+// is from a board encoding alone: X always moves first, so an odd count
+// means O moves next.
+func filledCells(gb *board.GameBoard) int {
+	count := 0
+	for i := 0; i < gb.Size(); i++ {
+		for j := 0; j < gb.Size(); j++ {
+			if gb.At(i, j) != ' ' {
+				count++
+			}
+		}
+	}
+	return count
+}
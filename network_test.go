@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// teeConn wraps a net.Conn and copies everything written through it into
+// tee, so a test can inspect the exact frames one side sent without
+// stealing bytes the other side still needs to read.
+type teeConn struct {
+	net.Conn
+	tee io.Writer
+}
+
+func (c *teeConn) Write(b []byte) (int, error) {
+	c.tee.Write(b)
+	return c.Conn.Write(b)
+}
+
+// TestServeGame_ListensOnLoopback spins up a real listener on 127.0.0.1:0
+// and checks that ServeGame surfaces a clear error once the listener is
+// closed without anyone connecting, rather than hanging forever.
+func TestServeGame_ListensOnLoopback(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen on 127.0.0.1:0: %v", err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		listener.Close()
+	}()
+
+	if err := ServeGame(listener); err == nil {
+		t.Error("Expected ServeGame to return an error once its listener closed, got nil")
+	}
+}
+
+// TestNetworkGame_FullGameCompletes drives a complete game over a
+// net.Pipe-backed connection pair using the JSON-framed Session protocol:
+// the host (X) and guest (O) each read scripted moves from their own
+// buffer, and the game should finish with X winning row 0.
+func TestNetworkGame_FullGameCompletes(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+
+	var hostFrames bytes.Buffer
+	sniffedServerConn := &teeConn{Conn: serverConn, tee: &hostFrames}
+
+	hostMoves := bufio.NewReader(strings.NewReader("0,0\n0,1\n0,2\n"))
+	guestMoves := bufio.NewReader(strings.NewReader("1,0\n1,1\n"))
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- serveSession(newSession(sniffedServerConn), hostMoves)
+	}()
+
+	joinErr := make(chan error, 1)
+	go func() {
+		joinErr <- joinSession(newSession(clientConn), guestMoves, "Guest")
+	}()
+
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			t.Errorf("serveSession returned an error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("serveSession did not complete in time")
+	}
+
+	select {
+	case err := <-joinErr:
+		if err != nil {
+			t.Errorf("joinSession returned an error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("joinSession did not complete in time")
+	}
+
+	winner := resultWinner(t, hostFrames.Bytes())
+	if winner != "X" {
+		t.Errorf("Expected the host's result frame to report X as the winner, got %q", winner)
+	}
+}
+
+// resultWinner scans frames (one JSON object per line, as sent over a
+// Session) for the terminal "result" frame and returns its Winner field,
+// failing the test if none is found.
+func resultWinner(t *testing.T, frames []byte) string {
+	t.Helper()
+	for _, line := range bytes.Split(frames, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var f frame
+		if err := json.Unmarshal(line, &f); err != nil {
+			t.Fatalf("decoding frame %q: %v", line, err)
+		}
+		if f.Type == "result" {
+			return f.Winner
+		}
+	}
+	t.Fatal("no result frame found among host's sent frames")
+	return ""
+}
+
+// TestEncodeDecodeCells_RoundTrip checks that a board's flattened cell
+// encoding round-trips through decodeCells unchanged.
+func TestEncodeDecodeCells_RoundTrip(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+	gb.PlaceMark(1, 1, 'O')
+
+	decoded, err := decodeCells(gb.Size(), gb.WinLength(), encodeCells(gb))
+	if err != nil {
+		t.Fatalf("decodeCells returned an error: %v", err)
+	}
+
+	for i := 0; i < gb.Size(); i++ {
+		for j := 0; j < gb.Size(); j++ {
+			if decoded.At(i, j) != gb.At(i, j) {
+				t.Errorf("Expected decoded cell (%d,%d) to be '%c', got '%c'", i, j, gb.At(i, j), decoded.At(i, j))
+			}
+		}
+	}
+}
+
+// TestSession_SendRecvMove checks that a move sent by one Session over a
+// net.Pipe round-trips correctly through RecvMove on the other end.
+func TestSession_SendRecvMove(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	sent := Move{Row: 2, Col: 1}
+	go func() {
+		newSession(serverConn).SendMove(sent, 1)
+	}()
+
+	got, err := newSession(clientConn).RecvMove()
+	if err != nil {
+		t.Fatalf("RecvMove returned an error: %v", err)
+	}
+	if got.Row != sent.Row || got.Col != sent.Col {
+		t.Errorf("Expected move %+v, got %+v", sent, got)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+	"github.com/dheeru0198/tic-tac-toe/record"
+)
+
+// Move records a single placed mark: where it went, who played it, and when.
+type Move struct {
+	Row         int
+	Col         int
+	Mark        rune
+	PlayerIndex int
+	Timestamp   time.Time
+}
+
+// replayGame loads a saved game from path (written by saveGameOrWarn in
+// record's PGN-like notation, the only format --save actually produces)
+// and prints it move-by-move with a pause between moves, so a user can
+// watch a previous game play out.
+func replayGame(path string, delay time.Duration) {
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Println("Failed to load game for replay:", err)
+		return
+	}
+	loaded, players, err := record.LoadGame(file)
+	file.Close()
+	if err != nil {
+		fmt.Println("Failed to load game for replay:", err)
+		return
+	}
+	history := loaded.MoveLog()
+
+	fmt.Printf("Replaying %s (%d moves)...\n", path, len(history))
+	gb := board.NewGameBoard(loaded.Size(), loaded.WinLength())
+	for _, mv := range history {
+		time.Sleep(delay)
+		gb.PlaceMark(mv.Row, mv.Col, mv.Mark)
+		gb.DisplayBoard()
+	}
+
+	switch status := gb.CheckStatus(); status {
+	case 'D':
+		fmt.Println("Replay ended in a draw.")
+	case ' ':
+		fmt.Println("Replay ended with no winner recorded.")
+	default:
+		winnerName := fmt.Sprintf("%c", status)
+		for _, p := range players {
+			if p.Mark == status {
+				winnerName = p.Name
+				break
+			}
+		}
+		fmt.Printf("Replay winner: %s (%c)\n", winnerName, status)
+	}
+}
+
+// saveGameOrWarn saves gb and players to path in record's PGN-like
+// notation, printing a warning instead of failing the caller if the write
+// doesn't succeed.
+func saveGameOrWarn(path string, gb *board.GameBoard, players []board.Player) {
+	file, err := os.Create(path)
+	if err != nil {
+		fmt.Println("Failed to save game:", err)
+		return
+	}
+	defer file.Close()
+
+	if err := record.SaveGame(file, gb, players, time.Now()); err != nil {
+		fmt.Println("Failed to save game:", err)
+		return
+	}
+	fmt.Println("Game saved to", path)
+}
+
+// setupSaveOnInterrupt arranges for gb and players to be written to path the
+// moment the process receives SIGINT (Ctrl-C), so an interrupted match
+// isn't lost. It returns a stop function the caller should defer to release
+// the signal handler once the match ends normally.
+func setupSaveOnInterrupt(path string, gb *board.GameBoard, players []board.Player) (stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+
+	go func() {
+		if _, ok := <-sigCh; !ok {
+			return
+		}
+		fmt.Println("\nInterrupted, saving game...")
+		saveGameOrWarn(path, gb, players)
+		os.Exit(0)
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(sigCh)
+	}
+}
+
+// resumeGame loads a saved game from loadPath, replays its move history onto
+// a fresh board, and then hands control back to the interactive text loop so
+// the user can keep playing from where they left off. If savePath is set,
+// the resumed game is saved there at the end or on Ctrl-C, same as a fresh
+// game started with --save.
+func resumeGame(reader *bufio.Reader, loadPath, savePath string) {
+	file, err := os.Open(loadPath)
+	if err != nil {
+		fmt.Println("Failed to load game to resume:", err)
+		return
+	}
+	gb, players, err := record.LoadGame(file)
+	file.Close()
+	if err != nil {
+		fmt.Println("Failed to load game to resume:", err)
+		return
+	}
+	if len(players) != 2 {
+		fmt.Println("Saved game does not have exactly two players; cannot resume")
+		return
+	}
+
+	history := make([]Move, len(gb.MoveLog()))
+	fmt.Printf("Resuming %s (%d moves played so far)...\n", loadPath, len(history))
+
+	var turnTakers [2]turnTaker
+	if savePath != "" {
+		stop := setupSaveOnInterrupt(savePath, gb, players)
+		defer stop()
+	}
+
+	playMatch(reader, gb, players[0], players[1], turnTakers, &history)
+
+	if savePath != "" {
+		saveGameOrWarn(savePath, gb, players)
+	}
+}
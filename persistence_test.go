@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestNextPlayerIndex_ResumesAtTheRightTurn checks that nextPlayerIndex
+// alternates starting from index 0, so resuming a loaded game with an odd
+// number of moves already played correctly hands the turn to index 1.
+func TestNextPlayerIndex_ResumesAtTheRightTurn(t *testing.T) {
+	testCases := []struct {
+		historyLen int
+		expected   int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 0},
+		{3, 1},
+	}
+
+	for _, tc := range testCases {
+		history := make([]Move, tc.historyLen)
+		if got := nextPlayerIndex(history); got != tc.expected {
+			t.Errorf("With %d moves played, expected index %d to move next, got %d", tc.historyLen, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Package record serializes a game to a small, chess-PGN-like text format:
+// a header block of metadata followed by numbered move pairs, one pair per
+// line, such as "1. X a1 O b2" then "2. X c3".
+package record
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// formatVersion is bumped whenever the on-disk layout changes, so LoadGame
+// can reject files it no longer knows how to read.
+const formatVersion = 1
+
+// Move is a single placed mark positioned in the move sequence by its
+// 1-based Ordinal. It is distinct from board.PlacedMove, which board uses
+// internally for undo and carries no notation concerns.
+type Move struct {
+	Row     int
+	Col     int
+	Mark    rune
+	Ordinal int
+}
+
+// Moves converts gb's internal move log into the ordered, 1-based Moves
+// this package's notation is built from.
+func Moves(gb *board.GameBoard) []Move {
+	log := gb.MoveLog()
+	moves := make([]Move, len(log))
+	for i, pm := range log {
+		moves[i] = Move{Row: pm.Row, Col: pm.Col, Mark: pm.Mark, Ordinal: i + 1}
+	}
+	return moves
+}
+
+// SaveGame writes gb's move history and players to w: a header block
+// (format version, board size, win length, timestamp, and one line per
+// player) followed by a blank line and the moves in PGN-like notation,
+// e.g. "1. X a1 O b2\n2. X c3\n".
+func SaveGame(w io.Writer, gb *board.GameBoard, players []board.Player, when time.Time) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "version %d\n", formatVersion)
+	fmt.Fprintf(bw, "size %d\n", gb.Size())
+	fmt.Fprintf(bw, "k %d\n", gb.WinLength())
+	fmt.Fprintf(bw, "date %s\n", when.Format(time.RFC3339))
+	for i, p := range players {
+		fmt.Fprintf(bw, "player%d %c %s\n", i+1, p.Mark, p.Name)
+	}
+	fmt.Fprintln(bw)
+
+	moves := Moves(gb)
+	for i := 0; i < len(moves); i += 2 {
+		fmt.Fprintf(bw, "%d. %c %s", i/2+1, moves[i].Mark, notate(moves[i]))
+		if i+1 < len(moves) {
+			fmt.Fprintf(bw, " %c %s", moves[i+1].Mark, notate(moves[i+1]))
+		}
+		fmt.Fprintln(bw)
+	}
+
+	return bw.Flush()
+}
+
+// notate renders a move's cell in chess-like algebraic notation: a letter
+// for the column followed by a 1-based row number, e.g. (0,0) -> "a1".
+func notate(mv Move) string {
+	return fmt.Sprintf("%c%d", 'a'+rune(mv.Col), mv.Row+1)
+}
+
+// parseCell parses a notate-style cell reference (e.g. "a1") back into a
+// 0-based (row, col) pair.
+func parseCell(s string) (row, col int, err error) {
+	if len(s) < 2 {
+		return 0, 0, fmt.Errorf("invalid cell %q", s)
+	}
+	col = int(s[0] - 'a')
+	row, err = strconv.Atoi(s[1:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid cell %q: %w", s, err)
+	}
+	return row - 1, col, nil
+}
+
+// LoadGame reads a game previously written by SaveGame, replays its move
+// history onto a fresh GameBoard, and returns the reconstructed board and
+// players.
+func LoadGame(r io.Reader) (*board.GameBoard, []board.Player, error) {
+	scanner := bufio.NewScanner(r)
+
+	var version, size, k int
+	var players []board.Player
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			break
+		}
+		fields := strings.Fields(line)
+		switch {
+		case fields[0] == "version":
+			version, _ = strconv.Atoi(fields[1])
+		case fields[0] == "size":
+			size, _ = strconv.Atoi(fields[1])
+		case fields[0] == "k":
+			k, _ = strconv.Atoi(fields[1])
+		case fields[0] == "date":
+			// Informational only; not needed to reconstruct the game.
+		case strings.HasPrefix(fields[0], "player"):
+			if len(fields) < 3 {
+				return nil, nil, fmt.Errorf("malformed player header %q", line)
+			}
+			players = append(players, board.Player{Name: strings.Join(fields[2:], " "), Mark: rune(fields[1][0])})
+		default:
+			return nil, nil, fmt.Errorf("unrecognized header line %q", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("reading header: %w", err)
+	}
+	if version != formatVersion {
+		return nil, nil, fmt.Errorf("unsupported record format version %d (expected %d)", version, formatVersion)
+	}
+
+	gb := board.NewGameBoard(size, k)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		// Each line reads "N. MARK CELL [MARK CELL]"; skip the move number.
+		for i := 1; i+1 < len(fields); i += 2 {
+			row, col, err := parseCell(fields[i+1])
+			if err != nil {
+				return nil, nil, fmt.Errorf("parsing move %q: %w", line, err)
+			}
+			if !gb.PlaceMark(row, col, rune(fields[i][0])) {
+				return nil, nil, fmt.Errorf("illegal move %s %s in %q", fields[i], fields[i+1], line)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("reading moves: %w", err)
+	}
+	gb.CheckStatus()
+
+	return gb, players, nil
+}
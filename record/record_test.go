@@ -0,0 +1,86 @@
+package record
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// TestSaveLoadGame_RoundTrip plays out a full game, saves it, loads it
+// back, and verifies the reconstructed board and winner match the
+// original.
+func TestSaveLoadGame_RoundTrip(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	players := []board.Player{
+		{Name: "Alice", Mark: 'X'},
+		{Name: "Bob", Mark: 'O'},
+	}
+
+	for _, mv := range []struct {
+		row, col int
+		mark     rune
+	}{
+		{0, 0, 'X'},
+		{1, 0, 'O'},
+		{0, 1, 'X'},
+		{1, 1, 'O'},
+		{0, 2, 'X'}, // X wins row 0
+	} {
+		gb.PlaceMark(mv.row, mv.col, mv.mark)
+	}
+	gb.CheckStatus()
+
+	var buf bytes.Buffer
+	if err := SaveGame(&buf, gb, players, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("SaveGame returned an error: %v", err)
+	}
+
+	loadedBoard, loadedPlayers, err := LoadGame(&buf)
+	if err != nil {
+		t.Fatalf("LoadGame returned an error: %v", err)
+	}
+
+	if loadedBoard.GetWinner() != gb.GetWinner() {
+		t.Errorf("Expected loaded winner '%c', got '%c'", gb.GetWinner(), loadedBoard.GetWinner())
+	}
+	for i := 0; i < gb.Size(); i++ {
+		for j := 0; j < gb.Size(); j++ {
+			if loadedBoard.At(i, j) != gb.At(i, j) {
+				t.Errorf("Expected loaded board cell (%d,%d) to be '%c', got '%c'", i, j, gb.At(i, j), loadedBoard.At(i, j))
+			}
+		}
+	}
+	if len(loadedPlayers) != len(players) || loadedPlayers[0].Name != players[0].Name {
+		t.Errorf("Expected loaded players %v, got %v", players, loadedPlayers)
+	}
+}
+
+// TestSaveGame_Notation checks that the written move lines use PGN-like
+// "N. MARK cell" notation with letters for columns and 1-based rows.
+func TestSaveGame_Notation(t *testing.T) {
+	gb := board.NewGameBoard(3, 3)
+	gb.PlaceMark(0, 0, 'X')
+	gb.PlaceMark(1, 1, 'O')
+	gb.PlaceMark(0, 1, 'X')
+
+	var buf bytes.Buffer
+	if err := SaveGame(&buf, gb, nil, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("SaveGame returned an error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "1. X a1 O b2\n2. X b1\n") {
+		t.Errorf("Expected PGN-like move notation in output, got:\n%s", buf.String())
+	}
+}
+
+// TestLoadGame_UnsupportedVersion checks that LoadGame rejects a header
+// whose version field doesn't match the version this package writes.
+func TestLoadGame_UnsupportedVersion(t *testing.T) {
+	r := strings.NewReader("version 9999\nsize 3\nk 3\n\n")
+	if _, _, err := LoadGame(r); err == nil {
+		t.Error("Expected LoadGame to reject an unsupported version, got no error")
+	}
+}
@@ -2,137 +2,20 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"math/rand"
+	"net"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
-)
-
-// Player struct holds the player's name and their mark ('X' or 'O').
-type Player struct {
-	Name string
-	Mark rune
-}
-
-// GameBoard struct represents the Tic-Tac-Toe board and game state.
-type GameBoard struct {
-	board  [3][3]rune
-	winner rune // 'X', 'O', 'D' (Draw), or ' ' (Pending)
-}
-
-// winningCombinations defines all possible winning lines on the board.
-// Each inner slice represents a cell as {row, col}.
-var winningCombinations = [][][]int{
-	// Rows
-	{{0, 0}, {0, 1}, {0, 2}},
-	{{1, 0}, {1, 1}, {1, 2}},
-	{{2, 0}, {2, 1}, {2, 2}},
-	// Columns
-	{{0, 0}, {1, 0}, {2, 0}},
-	{{0, 1}, {1, 1}, {2, 1}},
-	{{0, 2}, {1, 2}, {2, 2}},
-	// Diagonals
-	{{0, 0}, {1, 1}, {2, 2}},
-	{{0, 2}, {1, 1}, {2, 0}},
-}
-
-// NewGameBoard creates and returns a new GameBoard initialized for the start of a game.
-func NewGameBoard() *GameBoard {
-	gb := &GameBoard{winner: ' '} // Game is initially pending
-	for i := 0; i < 3; i++ {
-		for j := 0; j < 3; j++ {
-			gb.board[i][j] = ' ' // Initialize with empty spaces
-		}
-	}
-	return gb
-}
-
-// DisplayBoard prints the current state of the game board to the console.
-// Empty cells are shown with their "row,col" coordinates.
-func (gb *GameBoard) DisplayBoard() {
-	fmt.Println("\n    ") // Initial spacing
-	for i := 0; i < 3; i++ {
-		if i > 0 {
-			fmt.Println("    " + "---------------") // Separator line
-		}
-		rowStr := "    "
-		for j := 0; j < 3; j++ {
-			if gb.board[i][j] == ' ' {
-				rowStr += fmt.Sprintf("%d,%d", i, j)
-			} else {
-				rowStr += fmt.Sprintf(" %c ", gb.board[i][j])
-			}
-			if j < 2 {
-				rowStr += " | "
-			}
-		}
-		fmt.Println(rowStr)
-	}
-	fmt.Println("    \n") // Trailing spacing
-}
-
-// IsCellEmpty checks if the cell at the given row and column is empty.
-func (gb *GameBoard) IsCellEmpty(row, col int) bool {
-	if row < 0 || row > 2 || col < 0 || col > 2 {
-		return false // Out of bounds is not considered "empty" in a playable sense
-	}
-	return gb.board[row][col] == ' '
-}
-
-// PlaceMark attempts to place the given mark at the specified row and column.
-// It returns true if the mark was placed successfully (cell was empty and in bounds),
-// and false otherwise.
-func (gb *GameBoard) PlaceMark(row, col int, mark rune) bool {
-	if row >= 0 && row < 3 && col >= 0 && col < 3 && gb.board[row][col] == ' ' {
-		gb.board[row][col] = mark
-		return true
-	}
-	return false
-}
-
-// CheckStatus evaluates the board for a win, draw, or if the game is still pending.
-// It updates gb.winner and returns the status ('X', 'O', 'D' for Draw, ' ' for Pending).
-func (gb *GameBoard) CheckStatus() rune {
-	// Check for a win
-	for _, combination := range winningCombinations {
-		cell1 := gb.board[combination[0][0]][combination[0][1]]
-		cell2 := gb.board[combination[1][0]][combination[1][1]]
-		cell3 := gb.board[combination[2][0]][combination[2][1]]
-
-		if cell1 != ' ' && cell1 == cell2 && cell2 == cell3 {
-			gb.winner = cell1 // Winner found
-			return gb.winner
-		}
-	}
-
-	// Check for a draw (no empty cells left and no winner yet)
-	hasEmptyCell := false
-	for i := 0; i < 3; i++ {
-		for j := 0; j < 3; j++ {
-			if gb.board[i][j] == ' ' {
-				hasEmptyCell = true
-				break
-			}
-		}
-		if hasEmptyCell {
-			break
-		}
-	}
-
-	if !hasEmptyCell {
-		gb.winner = 'D' // Draw
-		return gb.winner
-	}
 
-	gb.winner = ' ' // Pending
-	return gb.winner
-}
-
-// GetWinner returns the current winner of the game ('X', 'O', 'D', or ' ').
-func (gb *GameBoard) GetWinner() rune {
-	return gb.winner
-}
+	"github.com/dheeru0198/tic-tac-toe/ai"
+	"github.com/dheeru0198/tic-tac-toe/board"
+	"github.com/dheeru0198/tic-tac-toe/tui"
+)
 
 // getInput reads a line of text from the console after printing a prompt.
 func getInput(prompt string, reader *bufio.Reader) string {
@@ -142,7 +25,7 @@ func getInput(prompt string, reader *bufio.Reader) string {
 }
 
 // handlePlayerTurn manages a single player's turn, including input and validation.
-func handlePlayerTurn(reader *bufio.Reader, currentPlayer Player, gb *GameBoard) {
+func handlePlayerTurn(reader *bufio.Reader, currentPlayer board.Player, playerIndex int, gb *board.GameBoard, history *[]Move) {
 	fmt.Printf("\n%s's turn (%c).\n", currentPlayer.Name, currentPlayer.Mark)
 	// Board is displayed by the main loop before calling this
 
@@ -166,8 +49,8 @@ func handlePlayerTurn(reader *bufio.Reader, currentPlayer Player, gb *GameBoard)
 			continue
 		}
 
-		if !(row >= 0 && row <= 2 && col >= 0 && col <= 2) {
-			fmt.Println("Invalid position. Row and column must be between 0 and 2.")
+		if !(row >= 0 && row < gb.Size() && col >= 0 && col < gb.Size()) {
+			fmt.Printf("Invalid position. Row and column must be between 0 and %d.\n", gb.Size()-1)
 			continue
 		}
 
@@ -177,6 +60,13 @@ func handlePlayerTurn(reader *bufio.Reader, currentPlayer Player, gb *GameBoard)
 		}
 
 		if gb.PlaceMark(row, col, currentPlayer.Mark) {
+			*history = append(*history, Move{
+				Row:         row,
+				Col:         col,
+				Mark:        currentPlayer.Mark,
+				PlayerIndex: playerIndex,
+				Timestamp:   time.Now(),
+			})
 			break // Valid move placed, exit loop
 		}
 		// Should not be reached if IsCellEmpty and bounds check are correct,
@@ -185,26 +75,242 @@ func handlePlayerTurn(reader *bufio.Reader, currentPlayer Player, gb *GameBoard)
 	}
 }
 
+// promptBoardConfig asks the user for the board size and win length at
+// startup, falling back to the classic 3x3 / 3-in-a-row defaults when the
+// user just presses enter. winLen is re-prompted until it is at most size.
+func promptBoardConfig(reader *bufio.Reader) (size, winLen int) {
+	for {
+		sizeStr := getInput("Enter board size (default 3): ", reader)
+		if sizeStr == "" {
+			size = 3
+			break
+		}
+		n, err := strconv.Atoi(sizeStr)
+		if err != nil || n < 3 {
+			fmt.Println("Invalid size. Please enter a whole number of 3 or more.")
+			continue
+		}
+		size = n
+		break
+	}
+
+	for {
+		winStr := getInput(fmt.Sprintf("Enter win length (default 3, must be <= %d): ", size), reader)
+		if winStr == "" {
+			winLen = 3
+			if winLen > size {
+				winLen = size
+			}
+			break
+		}
+		n, err := strconv.Atoi(winStr)
+		if err != nil || n < 2 || n > size {
+			fmt.Printf("Invalid win length. Please enter a whole number between 2 and %d.\n", size)
+			continue
+		}
+		winLen = n
+		break
+	}
+
+	return size, winLen
+}
+
+// promptGameMode asks the user for the game mode (Human vs Human, Human vs
+// AI, or AI vs AI) and, for the AI modes, a difficulty. It returns the mode
+// (1-3), whether Player 1 is also an AI (mode 3 only), and the difficulty.
+func promptGameMode(reader *bufio.Reader) (mode int, p1IsAI bool, difficulty Difficulty) {
+	for {
+		choice := getInput("Choose game mode - 1) Human vs Human  2) Human vs AI  3) AI vs AI: ", reader)
+		switch strings.TrimSpace(choice) {
+		case "1":
+			return 1, false, DifficultyEasy
+		case "2":
+			return 2, false, promptDifficulty(reader, "the AI")
+		case "3":
+			return 3, true, promptDifficulty(reader, "both AIs")
+		}
+		fmt.Println("Invalid choice. Please enter 1, 2, or 3.")
+	}
+}
+
+// promptDifficulty asks the user to pick an AI difficulty level.
+func promptDifficulty(reader *bufio.Reader, who string) Difficulty {
+	for {
+		choice := getInput(fmt.Sprintf("Choose a difficulty for %s - 1) Easy  2) Medium  3) Hard: ", who), reader)
+		switch strings.TrimSpace(choice) {
+		case "1":
+			return DifficultyEasy
+		case "2":
+			return DifficultyMedium
+		case "3":
+			return DifficultyHard
+		}
+		fmt.Println("Invalid choice. Please enter 1, 2, or 3.")
+	}
+}
+
+// cpuEngine maps a --cpu flag value to the ai.Engine that plays it.
+func cpuEngine(value string) (ai.Engine, error) {
+	switch value {
+	case "easy":
+		return ai.Random{}, nil
+	case "medium":
+		return ai.Heuristic{}, nil
+	case "hard":
+		return ai.Minimax{}, nil
+	}
+	return nil, fmt.Errorf("unknown --cpu value %q (want easy, medium, or hard)", value)
+}
+
+// cpuTurnTaker adapts an ai.Engine to the turnTaker interface used by
+// playMatch, binding it to the fixed mark the CPU plays.
+type cpuTurnTaker struct {
+	engine ai.Engine
+	mark   rune
+}
+
+// TakeTurn implements turnTaker.
+func (c cpuTurnTaker) TakeTurn(gb *board.GameBoard) (int, int) {
+	return c.engine.ChooseMove(gb, c.mark)
+}
+
+// playCPUGame sets up and runs a Human vs CPU match from the --cpu and
+// --first flags, skipping promptGameMode/promptDifficulty and the Player 2
+// name prompt. The human is still asked for the board size, win length and
+// their name; who
+// takes X and moves first is decided by first (human, cpu, or random).
+func playCPUGame(reader *bufio.Reader, cpu, first string) {
+	engine, err := cpuEngine(cpu)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	size, winLen := promptBoardConfig(reader)
+	humanName := getInput("Please enter your name: ", reader)
+
+	humanFirst := first == "human"
+	switch first {
+	case "human", "cpu":
+	case "random":
+		humanFirst = rand.Intn(2) == 0
+	default:
+		fmt.Printf("Unknown --first value %q (want human, cpu, or random)\n", first)
+		return
+	}
+
+	human := board.Player{Name: humanName, Mark: 'O'}
+	cpuPlayer := board.Player{Name: "CPU", Mark: 'X'}
+	if humanFirst {
+		human.Mark, cpuPlayer.Mark = 'X', 'O'
+	}
+
+	var turnTakers [2]turnTaker
+	player1, player2 := human, cpuPlayer
+	if humanFirst {
+		turnTakers[1] = cpuTurnTaker{engine: engine, mark: cpuPlayer.Mark}
+	} else {
+		player1, player2 = cpuPlayer, human
+		turnTakers[0] = cpuTurnTaker{engine: engine, mark: cpuPlayer.Mark}
+	}
+
+	gb := board.NewGameBoard(size, winLen)
+	var history []Move
+	playMatch(reader, gb, player1, player2, turnTakers, &history)
+}
+
 func main() {
+	flags := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	replayPath := flags.String("replay", "", "path to a saved game to replay instead of playing")
+	replayDelay := flags.Duration("replay-delay", 700*time.Millisecond, "delay between moves while replaying")
+	serveAddr := flags.String("serve", "", "address to listen on for a network opponent, e.g. :5000")
+	connectAddr := flags.String("connect", "", "address of a network host to join, e.g. 127.0.0.1:5000")
+	tuiMode := flags.Bool("tui", false, "play a local Human vs Human game in the Bubble Tea TUI instead of the text loop")
+	cpuFlag := flags.String("cpu", "none", "skip the game-mode and difficulty prompts and play Human vs CPU: none|easy|medium|hard")
+	firstFlag := flags.String("first", "human", "with --cpu, who moves first: human|cpu|random")
+	saveFlag := flags.String("save", "", "path to save the game to at game end or on Ctrl-C")
+	loadFlag := flags.String("load", "", "path to a saved game to resume and keep playing interactively")
+	flags.Parse(os.Args[1:])
+
+	if *replayPath != "" {
+		replayGame(*replayPath, *replayDelay)
+		return
+	}
+
+	if *serveAddr != "" {
+		listener, err := net.Listen("tcp", *serveAddr)
+		if err != nil {
+			fmt.Println("Failed to listen on", *serveAddr, ":", err)
+			return
+		}
+		defer listener.Close()
+		fmt.Println("Waiting for an opponent to connect on", *serveAddr, "...")
+		if err := ServeGame(listener); err != nil {
+			fmt.Println("Network game ended with an error:", err)
+		}
+		return
+	}
+
+	if *connectAddr != "" {
+		if err := JoinGame(*connectAddr); err != nil {
+			fmt.Println("Network game ended with an error:", err)
+		}
+		return
+	}
+
+	if *tuiMode {
+		if err := tui.Run(3, 3); err != nil {
+			fmt.Println("TUI game ended with an error:", err)
+		}
+		return
+	}
+
 	reader := bufio.NewReader(os.Stdin)
 	fmt.Println("Welcome to Tic-Tac-Toe (Go Version)!")
 
+	if *cpuFlag != "none" {
+		playCPUGame(reader, *cpuFlag, *firstFlag)
+		return
+	}
+
+	if *loadFlag != "" {
+		resumeGame(reader, *loadFlag, *saveFlag)
+		return
+	}
+
+	size, winLen := promptBoardConfig(reader)
+	mode, p1IsAI, difficulty := promptGameMode(reader)
+
 	// Player Setup
-	p1Name := getInput("Please enter a name for Player 1: ", reader)
+	var p1Name string
+	if p1IsAI {
+		p1Name = "AI-1"
+	} else {
+		p1Name = getInput("Please enter a name for Player 1: ", reader)
+	}
 	var p1Mark rune
-	for {
-		markInputStr := getInput(fmt.Sprintf("Please choose a mark (X or O) for %s: ", p1Name), reader)
-		if len(markInputStr) == 1 {
-			mark := unicode.ToUpper(rune(markInputStr[0]))
-			if mark == 'X' || mark == 'O' {
-				p1Mark = mark
-				break
+	if p1IsAI {
+		p1Mark = 'X'
+	} else {
+		for {
+			markInputStr := getInput(fmt.Sprintf("Please choose a mark (X or O) for %s: ", p1Name), reader)
+			if len(markInputStr) == 1 {
+				mark := unicode.ToUpper(rune(markInputStr[0]))
+				if mark == 'X' || mark == 'O' {
+					p1Mark = mark
+					break
+				}
 			}
+			fmt.Println("Invalid mark. Please choose X or O.")
 		}
-		fmt.Println("Invalid mark. Please choose X or O.")
 	}
 
-	p2Name := getInput("Please enter a name for Player 2: ", reader)
+	var p2Name string
+	if mode == 2 || mode == 3 {
+		p2Name = "AI-2"
+	} else {
+		p2Name = getInput("Please enter a name for Player 2: ", reader)
+	}
 	var p2Mark rune
 	if p1Mark == 'X' {
 		p2Mark = 'O'
@@ -212,41 +318,91 @@ func main() {
 		p2Mark = 'X'
 	}
 
-	player1 := Player{Name: p1Name, Mark: p1Mark}
-	player2 := Player{Name: p2Name, Mark: p2Mark}
+	var turnTakers [2]turnTaker
+	player1 := board.Player{Name: p1Name, Mark: p1Mark}
+	player2 := board.Player{Name: p2Name, Mark: p2Mark}
+	if mode == 3 {
+		turnTakers[0] = NewAIPlayer(player1.Name, player1.Mark, difficulty)
+	}
+	if mode == 2 || mode == 3 {
+		turnTakers[1] = NewAIPlayer(player2.Name, player2.Mark, difficulty)
+	}
+
+	gb := board.NewGameBoard(size, winLen)
+	var history []Move
+	if *saveFlag != "" {
+		stop := setupSaveOnInterrupt(*saveFlag, gb, []board.Player{player1, player2})
+		defer stop()
+	}
+	playMatch(reader, gb, player1, player2, turnTakers, &history)
+	if *saveFlag != "" {
+		saveGameOrWarn(*saveFlag, gb, []board.Player{player1, player2})
+	}
+}
+
+// turnTaker is implemented by anything that can choose a move for itself,
+// such as AIPlayer. A nil turnTaker in playMatch's turnTakers array means
+// that side is a local human, driven by handlePlayerTurn instead.
+type turnTaker interface {
+	TakeTurn(gb *board.GameBoard) (int, int)
+}
 
+// nextPlayerIndex returns the index into playMatch's players/turnTakers
+// arrays (0 or 1) that moves next given moves already played: the two
+// sides alternate starting with index 0, so an even-length history means
+// it's index 0's turn again. This lets playMatch resume a loaded game at
+// the right player instead of always starting over from index 0.
+func nextPlayerIndex(history []Move) int {
+	return len(history) % 2
+}
+
+// playMatch runs the turn loop for a single game on gb between player1
+// and player2, printing the board and prompting or delegating to
+// turnTakers[i] for each side's move, until the game ends. history is
+// appended to in place; its starting length decides who moves first (see
+// nextPlayerIndex), so resumeGame passes one entry per move already on gb.
+func playMatch(reader *bufio.Reader, gb *board.GameBoard, player1, player2 board.Player, turnTakers [2]turnTaker, history *[]Move) {
 	fmt.Printf("\n%s uses %c\n", player1.Name, player1.Mark)
 	fmt.Printf("%s uses %c\n", player2.Name, player2.Mark)
 
-	// Game Initialization
-	board := NewGameBoard()
 	fmt.Println("\nInitializing Game Board....")
-	fmt.Println("Game Started.\n=============\n")
-	
-	currentPlayer := player1
-	gameStatus := board.CheckStatus() // Should be ' ' initially
+	fmt.Println("Game Started.\n=============")
+
+	players := [2]board.Player{player1, player2}
+	turnIndex := nextPlayerIndex(*history)
+	gameStatus := gb.CheckStatus() // Should be ' ' initially if history is empty
 
 	for gameStatus == ' ' {
-		board.DisplayBoard()
-		fmt.Printf("\nChoose a position from available positions on the board (e.g., 0,1).\n")
-		handlePlayerTurn(reader, currentPlayer, board)
-		
-		gameStatus = board.CheckStatus()
+		gb.DisplayBoard()
+		currentPlayer := players[turnIndex]
+		if taker := turnTakers[turnIndex]; taker != nil {
+			row, col := taker.TakeTurn(gb)
+			fmt.Printf("\n%s's turn (%c). %s plays %d,%d.\n", currentPlayer.Name, currentPlayer.Mark, currentPlayer.Name, row, col)
+			gb.PlaceMark(row, col, currentPlayer.Mark)
+			*history = append(*history, Move{
+				Row:         row,
+				Col:         col,
+				Mark:        currentPlayer.Mark,
+				PlayerIndex: turnIndex,
+				Timestamp:   time.Now(),
+			})
+		} else {
+			fmt.Printf("\nChoose a position from available positions on the board (e.g., 0,1).\n")
+			handlePlayerTurn(reader, currentPlayer, turnIndex, gb, history)
+		}
+
+		gameStatus = gb.CheckStatus()
 		if gameStatus != ' ' {
-			board.DisplayBoard() // Display final board state
+			gb.DisplayBoard() // Display final board state
 			break
 		}
 
-		if currentPlayer.Name == player1.Name {
-			currentPlayer = player2
-		} else {
-			currentPlayer = player1
-		}
+		turnIndex = 1 - turnIndex
 	}
 
 	// End Game
 	fmt.Println("\nGame Over.")
-	winner := board.GetWinner()
+	winner := gb.GetWinner()
 	if winner == 'D' {
 		fmt.Println("Game ended in a draw.")
 	} else if winner != ' ' {
@@ -262,4 +418,3 @@ func main() {
 		fmt.Println("Game ended unexpectedly.")
 	}
 }
-```
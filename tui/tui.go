@@ -0,0 +1,188 @@
+// Package tui implements the Bubble Tea cursor-driven TUI front-end for
+// the game, as an alternative to the classic text loop in package main.
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dheeru0198/tic-tac-toe/board"
+)
+
+// styles used to render marks and the winning line in the TUI.
+var (
+	xStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true)  // blue
+	oStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("203")).Bold(true) // red
+	winStyle  = lipgloss.NewStyle().Background(lipgloss.Color("22")).Bold(true)  // green highlight
+	cursorFmt = "[%s]"
+)
+
+// model is the Bubble Tea model for the cursor-driven TUI front-end. It
+// plays Human vs Human locally: P1 is X and moves first, P2 is O.
+type model struct {
+	gb      *board.GameBoard
+	cursor  [2]int
+	players [2]board.Player
+	turn    int // index into players of whoever moves next
+	message string
+}
+
+// Run launches the Bubble Tea TUI front-end on a fresh size x size board
+// with the given win length, as an alternative to the classic text loop.
+func Run(size, winLen int) error {
+	m := newModel(size, winLen)
+	_, err := tea.NewProgram(m).Run()
+	return err
+}
+
+func newModel(size, winLen int) model {
+	return model{
+		gb: board.NewGameBoard(size, winLen),
+		players: [2]board.Player{
+			{Name: "Player 1", Mark: 'X'},
+			{Name: "Player 2", Mark: 'O'},
+		},
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "r":
+		m.gb = board.NewGameBoard(m.gb.Size(), m.gb.WinLength())
+		m.turn = 0
+		m.message = ""
+		return m, nil
+	case "u":
+		if m.gb.UndoLastMove() {
+			m.turn = 1 - m.turn
+			m.message = ""
+		}
+		return m, nil
+	case "up", "k":
+		m.moveCursor(-1, 0)
+	case "down", "j":
+		m.moveCursor(1, 0)
+	case "left", "h":
+		m.moveCursor(0, -1)
+	case "right", "l":
+		m.moveCursor(0, 1)
+	case "enter", " ":
+		m.place()
+	}
+	return m, nil
+}
+
+// moveCursor shifts the cursor by (dRow, dCol), clamped to the board.
+func (m *model) moveCursor(dRow, dCol int) {
+	row := m.cursor[0] + dRow
+	col := m.cursor[1] + dCol
+	if row < 0 {
+		row = 0
+	}
+	if row >= m.gb.Size() {
+		row = m.gb.Size() - 1
+	}
+	if col < 0 {
+		col = 0
+	}
+	if col >= m.gb.Size() {
+		col = m.gb.Size() - 1
+	}
+	m.cursor = [2]int{row, col}
+}
+
+// place attempts to play the current player's mark at the cursor, and
+// advances the turn on success. It does nothing once the game has ended.
+func (m *model) place() {
+	if m.gb.CheckStatus() != ' ' {
+		return
+	}
+	current := m.players[m.turn]
+	if !m.gb.PlaceMark(m.cursor[0], m.cursor[1], current.Mark) {
+		m.message = "Cell already occupied."
+		return
+	}
+	m.message = ""
+	m.turn = 1 - m.turn
+}
+
+func (m model) View() string {
+	var sb strings.Builder
+	status := m.gb.CheckStatus()
+	winLine := m.gb.WinningLine()
+
+	sb.WriteString("Tic-Tac-Toe — arrows/hjkl move, enter/space place, u undo, r reset, q quit\n\n")
+
+	for i := 0; i < m.gb.Size(); i++ {
+		for j := 0; j < m.gb.Size(); j++ {
+			cell := m.renderCell(i, j, winLine)
+			sb.WriteString(cell)
+			if j < m.gb.Size()-1 {
+				sb.WriteString(" ")
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	switch status {
+	case 'D':
+		sb.WriteString("Game over: draw.\n")
+	case ' ':
+		sb.WriteString(fmt.Sprintf("%s's turn (%c).\n", m.players[m.turn].Name, m.players[m.turn].Mark))
+	default:
+		winnerName := m.players[0].Name
+		if m.players[1].Mark == status {
+			winnerName = m.players[1].Name
+		}
+		sb.WriteString(fmt.Sprintf("Game over: %s (%c) wins!\n", winnerName, status))
+	}
+	if m.message != "" {
+		sb.WriteString(m.message + "\n")
+	}
+
+	return sb.String()
+}
+
+// renderCell returns the styled, fixed-width text for the cell at (row, col),
+// wrapping it in brackets if it's under the cursor and highlighting it if
+// it's part of the winning line.
+func (m model) renderCell(row, col int, winLine [][2]int) string {
+	mark := m.gb.At(row, col)
+
+	symbol := " "
+	switch mark {
+	case 'X':
+		symbol = xStyle.Render("X")
+	case 'O':
+		symbol = oStyle.Render("O")
+	default:
+		symbol = "."
+	}
+
+	for _, cell := range winLine {
+		if cell[0] == row && cell[1] == col {
+			symbol = winStyle.Render(string(mark))
+			break
+		}
+	}
+
+	if m.cursor[0] == row && m.cursor[1] == col {
+		return fmt.Sprintf(cursorFmt, symbol)
+	}
+	return " " + symbol + " "
+}